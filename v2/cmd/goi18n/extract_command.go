@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/abdullahskartal/go-i18n/v2/i18n"
+)
+
+// extractCommand implements "goi18n extract": it scans Go source for
+// i18n.Message{...} literals (including ones passed as the DefaultMessage
+// of a LocalizeConfig in a Localizer.Localize/MustLocalize call) and writes
+// one active.<sourceLanguage>.<format> file per country containing every
+// message it found.
+type extractCommand struct {
+	countryCode    string
+	sourceLanguage string
+	outDir         string
+	format         string
+	paths          []string
+}
+
+func (ec *extractCommand) name() string { return "extract" }
+
+func (ec *extractCommand) parse(args []string) error {
+	flagSet := newFlagSet(ec.name())
+	flagSet.StringVar(&ec.countryCode, "country", "tr", "country code the extracted messages belong to")
+	flagSet.StringVar(&ec.sourceLanguage, "source-language", "en", "source language of the extracted messages")
+	flagSet.StringVar(&ec.outDir, "outdir", ".", "directory to write the active message file to")
+	flagSet.StringVar(&ec.format, "format", "toml", "output format (toml, json, yaml)")
+	if err := flagSet.Parse(args); err != nil {
+		return err
+	}
+	ec.paths = flagSet.Args()
+	if len(ec.paths) == 0 {
+		ec.paths = []string{"."}
+	}
+	return nil
+}
+
+func (ec *extractCommand) execute() error {
+	messages, err := extractMessages(ec.paths)
+	if err != nil {
+		return err
+	}
+
+	mf := &i18n.MessageFile{
+		Path:     filepath.Join(ec.outDir, fmt.Sprintf("active.%s.%s", ec.sourceLanguage, ec.format)),
+		Messages: messages,
+	}
+	if err := writeMessageFile(mf, ec.countryCode); err != nil {
+		return err
+	}
+	fmt.Printf("extracted %d messages for %s/%s\n", len(messages), ec.countryCode, ec.sourceLanguage)
+	return nil
+}
+
+// extractMessages walks paths looking for Go source files and collects the
+// i18n.Message literals it finds in them, deduplicated by ID.
+func extractMessages(paths []string) ([]*i18n.Message, error) {
+	fset := token.NewFileSet()
+	seen := map[string]bool{}
+	var messages []*i18n.Message
+
+	visit := func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".go" {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			lit, ok := n.(*ast.CompositeLit)
+			if !ok || !isMessageLiteral(lit) {
+				return true
+			}
+			m := messageFromLiteral(lit)
+			if m.ID == "" || seen[m.ID] {
+				return true
+			}
+			seen[m.ID] = true
+			messages = append(messages, m)
+			return true
+		})
+		return nil
+	}
+
+	for _, root := range paths {
+		if err := filepath.Walk(root, visit); err != nil {
+			return nil, err
+		}
+	}
+	return messages, nil
+}
+
+// isMessageLiteral reports whether lit is an i18n.Message{...} or
+// Message{...} (dot-imported) composite literal.
+func isMessageLiteral(lit *ast.CompositeLit) bool {
+	switch t := lit.Type.(type) {
+	case *ast.SelectorExpr:
+		return t.Sel.Name == "Message"
+	case *ast.Ident:
+		return t.Name == "Message"
+	default:
+		return false
+	}
+}
+
+// messageFromLiteral converts a Message{...} composite literal's
+// key-value fields into an *i18n.Message.
+func messageFromLiteral(lit *ast.CompositeLit) *i18n.Message {
+	m := &i18n.Message{}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		value := stringLiteral(kv.Value)
+		switch key.Name {
+		case "ID":
+			m.ID = value
+		case "Description":
+			m.Description = value
+		case "Zero":
+			m.Zero = value
+		case "One":
+			m.One = value
+		case "Two":
+			m.Two = value
+		case "Few":
+			m.Few = value
+		case "Many":
+			m.Many = value
+		case "Other":
+			m.Other = value
+		}
+	}
+	return m
+}
+
+func stringLiteral(expr ast.Expr) string {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return ""
+	}
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return ""
+	}
+	return s
+}
@@ -0,0 +1,62 @@
+// Command goi18n manages message files used by the i18n package.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+// command is implemented by each goi18n subcommand.
+type command interface {
+	name() string
+	parse(args []string) error
+	execute() error
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(2)
+	}
+
+	cmds := []command{
+		&extractCommand{},
+		&mergeCommand{},
+		&statsCommand{},
+	}
+
+	for _, cmd := range cmds {
+		if cmd.name() != os.Args[1] {
+			continue
+		}
+		if err := cmd.parse(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		if err := cmd.execute(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	printUsage()
+	os.Exit(2)
+}
+
+func printUsage() {
+	fmt.Fprintln(os.Stderr, `goi18n is a tool for managing go-i18n message files.
+
+Usage:
+
+	goi18n extract [options] [path ...]
+	goi18n merge [options] <first.toml> [others...]
+	goi18n stats [options] [path ...]
+
+Run "goi18n <command> -help" for details on a specific command.`)
+}
+
+func newFlagSet(cmdName string) *flag.FlagSet {
+	return flag.NewFlagSet(cmdName, flag.ExitOnError)
+}
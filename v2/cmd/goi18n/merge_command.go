@@ -0,0 +1,129 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/abdullahskartal/go-i18n/v2/i18n"
+)
+
+// mergeCommand implements "goi18n merge": given a set of message files, it
+// groups them by country (inferred from each file's parent directory, e.g.
+// lang/gb/active.en.toml belongs to country "gb") and, for every country,
+// diffs every non-source-language file against the source-language one.
+// For each (country, language) pair it writes translate.<lang>.<format>
+// containing only the messages that are missing from the translation file
+// or whose Hash no longer matches the source message's Hash.
+type mergeCommand struct {
+	sourceLanguage string
+	outDir         string
+	paths          []string
+}
+
+func (mc *mergeCommand) name() string { return "merge" }
+
+func (mc *mergeCommand) parse(args []string) error {
+	flagSet := newFlagSet(mc.name())
+	flagSet.StringVar(&mc.sourceLanguage, "source-language", "en", "language the other message files are translated from")
+	flagSet.StringVar(&mc.outDir, "outdir", ".", "directory to write translate.* files to")
+	if err := flagSet.Parse(args); err != nil {
+		return err
+	}
+	mc.paths = flagSet.Args()
+	if len(mc.paths) == 0 {
+		return fmt.Errorf("merge: at least one message file is required")
+	}
+	return nil
+}
+
+func (mc *mergeCommand) execute() error {
+	byCountry := map[string][]string{}
+	for _, path := range mc.paths {
+		country := strings.ToLower(filepath.Base(filepath.Dir(path)))
+		byCountry[country] = append(byCountry[country], path)
+	}
+
+	for country, paths := range byCountry {
+		if err := mc.mergeCountry(country, paths); err != nil {
+			return fmt.Errorf("%s: %w", country, err)
+		}
+	}
+	return nil
+}
+
+func (mc *mergeCommand) mergeCountry(country string, paths []string) error {
+	var source *i18n.MessageFile
+	files := make([]*i18n.MessageFile, 0, len(paths))
+
+	for _, path := range paths {
+		mf, err := readMessageFile(path)
+		if err != nil {
+			return err
+		}
+		files = append(files, mf)
+		if mf.Tag.String() == mc.sourceLanguage || strings.Contains(filepath.Base(path), "."+mc.sourceLanguage+".") {
+			source = mf
+		}
+	}
+	if source == nil {
+		return fmt.Errorf("no message file found for source language %q", mc.sourceLanguage)
+	}
+
+	sourceByID := make(map[string]*i18n.Message, len(source.Messages))
+	for _, m := range source.Messages {
+		sourceByID[m.ID] = hashed(m)
+	}
+
+	for _, mf := range files {
+		if mf == source {
+			continue
+		}
+
+		existing := make(map[string]*i18n.Message, len(mf.Messages))
+		for _, m := range mf.Messages {
+			existing[m.ID] = m
+		}
+
+		var untranslated []*i18n.Message
+		for id, srcMsg := range sourceByID {
+			have, ok := existing[id]
+			if !ok || have.Hash != srcMsg.Hash {
+				untranslated = append(untranslated, srcMsg)
+			}
+		}
+		if len(untranslated) == 0 {
+			continue
+		}
+
+		translateFile := &i18n.MessageFile{
+			Path:     filepath.Join(mc.outDir, fmt.Sprintf("translate.%s.%s", langFromPath(mf.Path), formatOf(mf.Path))),
+			Messages: untranslated,
+		}
+		if err := writeMessageFile(translateFile, country); err != nil {
+			return err
+		}
+		fmt.Printf("%s: %d message(s) missing or out of date for %s\n", country, len(untranslated), langFromPath(mf.Path))
+	}
+	return nil
+}
+
+// langFromPath extracts the language component of an active.<lang>.<fmt>
+// or translate.<lang>.<fmt> file name.
+func langFromPath(path string) string {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	parts := strings.Split(name, ".")
+	return parts[len(parts)-1]
+}
+
+// hashed returns a copy of m with Hash set to the hash of its translatable
+// content, so callers can compare it against a previously hashed message
+// to detect upstream edits.
+func hashed(m *i18n.Message) *i18n.Message {
+	cp := *m
+	h := sha256.Sum256([]byte(cp.Zero + "\x00" + cp.One + "\x00" + cp.Two + "\x00" + cp.Few + "\x00" + cp.Many + "\x00" + cp.Other))
+	cp.Hash = hex.EncodeToString(h[:])
+	return &cp
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/abdullahskartal/go-i18n/v2/i18n"
+	"gopkg.in/yaml.v3"
+)
+
+// marshalFunc is the write-side counterpart of i18n.UnmarshalFunc.
+type marshalFunc func(v interface{}) ([]byte, error)
+
+// formatCodecs pairs every format goi18n can emit with the unmarshal func a
+// Bundle would register for it, so merge can round-trip existing
+// translation files exactly the way the running application reads them.
+var formatCodecs = map[string]struct {
+	unmarshal i18n.UnmarshalFunc
+	marshal   marshalFunc
+}{
+	"toml": {
+		unmarshal: func(data []byte, v interface{}) error { return toml.Unmarshal(data, v) },
+		marshal: func(v interface{}) ([]byte, error) {
+			var buf strings.Builder
+			if err := toml.NewEncoder(&buf).Encode(v); err != nil {
+				return nil, err
+			}
+			return []byte(buf.String()), nil
+		},
+	},
+	"json": {
+		unmarshal: json.Unmarshal,
+		marshal:   func(v interface{}) ([]byte, error) { return json.MarshalIndent(v, "", "  ") },
+	},
+	"yaml": {
+		unmarshal: yaml.Unmarshal,
+		marshal:   yaml.Marshal,
+	},
+}
+
+// formatOf returns everything after the last "." in path, e.g. "toml".
+func formatOf(path string) string {
+	return strings.TrimPrefix(filepath.Ext(path), ".")
+}
+
+// readMessageFile loads path into an *i18n.MessageFile using the codec for
+// its format.
+func readMessageFile(path string) (*i18n.MessageFile, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	codec, ok := formatCodecs[formatOf(path)]
+	if !ok {
+		return nil, fmt.Errorf("%s: unsupported format %q", path, formatOf(path))
+	}
+	unmarshalFuncs := map[string]i18n.UnmarshalFunc{formatOf(path): codec.unmarshal}
+	return i18n.ParseMessageFileBytes(buf, path, unmarshalFuncs)
+}
+
+// writeMessageFile marshals mf's messages with the codec for mf.Path's
+// format and writes them under a subdirectory named for the lower-cased
+// countryCode (dir/active.en.json for "GB" becomes dir/gb/active.en.json),
+// creating that directory as needed.
+func writeMessageFile(mf *i18n.MessageFile, countryCode string) error {
+	codec, ok := formatCodecs[formatOf(mf.Path)]
+	if !ok {
+		return fmt.Errorf("%s: unsupported format %q", mf.Path, formatOf(mf.Path))
+	}
+
+	messagesByID := make(map[string]*i18n.Message, len(mf.Messages))
+	for _, m := range mf.Messages {
+		messagesByID[m.ID] = m
+	}
+
+	buf, err := codec.marshal(messagesByID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", mf.Path, err)
+	}
+	if err := os.MkdirAll(filepath.Join(filepath.Dir(mf.Path), strings.ToLower(countryCode)), 0o755); err != nil {
+		return err
+	}
+	path := filepath.Join(filepath.Dir(mf.Path), strings.ToLower(countryCode), filepath.Base(mf.Path))
+	return os.WriteFile(path, buf, 0o644)
+}
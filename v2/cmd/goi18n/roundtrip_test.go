@@ -0,0 +1,98 @@
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// captureStdout runs fn with os.Stdout redirected to a pipe and returns
+// whatever it wrote.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := os.Stdout
+	os.Stdout = w
+	fn()
+	os.Stdout = old
+	w.Close()
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+// TestExtractMergeStatsRoundTrip exercises extract, merge, and stats
+// against the same tree of files, the way a translator workflow would run
+// them back to back.
+func TestExtractMergeStatsRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+
+	const src = `package app
+
+import "github.com/abdullahskartal/go-i18n/v2/i18n"
+
+var greeting = i18n.Message{
+	ID:    "greeting",
+	Other: "Hello",
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "app.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ec := &extractCommand{
+		countryCode:    "gb",
+		sourceLanguage: "en",
+		outDir:         dir,
+		format:         "json",
+		paths:          []string{dir},
+	}
+	if err := ec.execute(); err != nil {
+		t.Fatalf("extract: %v", err)
+	}
+	sourcePath := filepath.Join(dir, "gb", "active.en.json")
+	if _, err := os.Stat(sourcePath); err != nil {
+		t.Fatalf("extract did not write %s: %v", sourcePath, err)
+	}
+
+	// An existing tr translation that doesn't yet have "greeting" - merge
+	// should flag it as untranslated.
+	existingTrPath := filepath.Join(dir, "gb", "active.tr.json")
+	if err := os.WriteFile(existingTrPath, []byte(`{}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	mc := &mergeCommand{
+		sourceLanguage: "en",
+		outDir:         dir,
+		paths:          []string{sourcePath, existingTrPath},
+	}
+	if err := mc.execute(); err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	translatePath := filepath.Join(dir, "gb", "translate.tr.json")
+	mf, err := readMessageFile(translatePath)
+	if err != nil {
+		t.Fatalf("merge did not write %s: %v", translatePath, err)
+	}
+	if len(mf.Messages) != 1 || mf.Messages[0].ID != "greeting" {
+		t.Fatalf("translate file messages = %+v, want [greeting]", mf.Messages)
+	}
+
+	sc := &statsCommand{paths: []string{dir}}
+	out := captureStdout(t, func() {
+		if err := sc.execute(); err != nil {
+			t.Fatalf("stats: %v", err)
+		}
+	})
+	if want := "gb/tr: 1 untranslated"; !strings.Contains(out, want) {
+		t.Fatalf("stats output %q does not contain %q", out, want)
+	}
+}
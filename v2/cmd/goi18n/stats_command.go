@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// statsCommand implements "goi18n stats": it walks the given paths for
+// translate.<lang>.<fmt> files (the output of "goi18n merge") and reports
+// how many messages are still untranslated for each (country, language)
+// pair, so a team can see catalog completeness at a glance.
+type statsCommand struct {
+	paths []string
+}
+
+func (sc *statsCommand) name() string { return "stats" }
+
+func (sc *statsCommand) parse(args []string) error {
+	flagSet := newFlagSet(sc.name())
+	if err := flagSet.Parse(args); err != nil {
+		return err
+	}
+	sc.paths = flagSet.Args()
+	if len(sc.paths) == 0 {
+		sc.paths = []string{"."}
+	}
+	return nil
+}
+
+type statsKey struct {
+	country, language string
+}
+
+func (sc *statsCommand) execute() error {
+	counts := map[statsKey]int{}
+
+	visit := func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasPrefix(filepath.Base(path), "translate.") {
+			return nil
+		}
+		mf, err := readMessageFile(path)
+		if err != nil {
+			return err
+		}
+		key := statsKey{
+			country:  strings.ToLower(filepath.Base(filepath.Dir(path))),
+			language: langFromPath(path),
+		}
+		counts[key] += len(mf.Messages)
+		return nil
+	}
+
+	for _, root := range sc.paths {
+		if err := filepath.Walk(root, visit); err != nil {
+			return err
+		}
+	}
+
+	keys := make([]statsKey, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].country != keys[j].country {
+			return keys[i].country < keys[j].country
+		}
+		return keys[i].language < keys[j].language
+	})
+
+	for _, k := range keys {
+		fmt.Printf("%s/%s: %d untranslated\n", k.country, k.language, counts[k])
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package i18n
 import (
 	"fmt"
 	"os"
+	"sync"
+	"sync/atomic"
 
 	"github.com/abdullahskartal/go-i18n/v2/internal/plural"
 
@@ -12,18 +14,40 @@ import (
 // UnmarshalFunc unmarshals data into v.
 type UnmarshalFunc func(data []byte, v interface{}) error
 
+// bundleState holds everything in a Bundle that is replaced wholesale on
+// every reload. Readers always load a single *bundleState and work off of
+// it, so a reload can never hand back a map that is half old, half new.
+type bundleState struct {
+	messageTemplates map[string]map[string]Template
+	countryTagPair   map[string][]language.Tag
+	matchers         map[string]language.Matcher
+}
+
 // Bundle stores a set of messages and pluralization rules.
 // Most applications only need a single bundle
 // that is initialized early in the application's lifecycle.
-// It is not goroutine safe to modify the bundle while Localizers
-// are reading from it.
+//
+// Bundle is safe for concurrent use: Localizers may read from it while any
+// number of goroutines call LoadMessageFile, LoadFS, AddMessages, Merge, or
+// Watch to modify it at the same time. A read will observe either the
+// state from before a given write or the state from after it, never a
+// torn map, and concurrent writes serialize against each other rather than
+// clobbering one another - this is what lets RegisterSource run one
+// refresh goroutine per registered source without its callers having to
+// coordinate among themselves.
 type Bundle struct {
-	defaultLanguage  language.Tag
-	unmarshalFuncs   map[string]UnmarshalFunc
-	messageTemplates map[string]map[string]*MessageTemplate
-	pluralRules      plural.Rules
-	countryTagPair   map[string][]language.Tag
-	matcher          language.Matcher
+	defaultLanguage language.Tag
+	unmarshalFuncs  map[string]UnmarshalFunc
+	pluralRules     plural.Rules
+	ordinalRules    plural.OrdinalRules
+	state           atomic.Pointer[bundleState]
+	stateMu         sync.Mutex
+	sourcesMu       sync.Mutex
+	sources         []fsSource
+	cacheMu         sync.Mutex
+	cache           Store
+	templateParser  TemplateParser
+	fallbackChains  map[string][]FallbackStep
 }
 
 // artTag is the language tag used for artificial languages
@@ -35,8 +59,14 @@ func NewBundle(countryCode string, defaultLanguage language.Tag) *Bundle {
 	b := &Bundle{
 		defaultLanguage: defaultLanguage,
 		pluralRules:     plural.DefaultRules(),
+		ordinalRules:    plural.DefaultOrdinalRules(),
 	}
 	b.pluralRules[artTag] = b.pluralRules.Rule(language.English)
+	b.state.Store(&bundleState{
+		messageTemplates: map[string]map[string]Template{},
+		countryTagPair:   map[string][]language.Tag{},
+		matchers:         map[string]language.Matcher{},
+	})
 	b.addTag(countryCode, defaultLanguage)
 	return b
 }
@@ -98,17 +128,24 @@ func (b *Bundle) AddMessages(countryCode string, tag language.Tag, messages ...*
 	if pluralRule == nil {
 		return fmt.Errorf("no plural rule registered for %s", tag)
 	}
+	b.addTag(countryCode, tag)
 	key := b.messageTemplateKey(countryCode, tag)
-	if b.messageTemplates == nil {
-		b.messageTemplates = map[string]map[string]*MessageTemplate{}
-	}
-	if b.messageTemplates[key] == nil {
-		b.messageTemplates[key] = map[string]*MessageTemplate{}
-		b.addTag(countryCode, tag)
-	}
+	templates := make(map[string]Template, len(messages))
 	for _, m := range messages {
-		b.messageTemplates[key][m.ID] = NewMessageTemplate(m)
+		tmpl, err := b.newTemplate(m, tag)
+		if err != nil {
+			return fmt.Errorf("message %q: %w", m.ID, err)
+		}
+		templates[m.ID] = tmpl
 	}
+	b.mutateState(func(s *bundleState) {
+		if s.messageTemplates[key] == nil {
+			s.messageTemplates[key] = map[string]Template{}
+		}
+		for id, tmpl := range templates {
+			s.messageTemplates[key][id] = tmpl
+		}
+	})
 	return nil
 }
 
@@ -120,37 +157,77 @@ func (b *Bundle) MustAddMessages(countryCode string, tag language.Tag, messages
 }
 
 func (b *Bundle) addTag(countryCode string, tag language.Tag) {
-	for cc, tags := range b.countryTagPair {
-		for _, t := range tags {
-			if cc == countryCode && t == tag {
+	b.mutateState(func(s *bundleState) {
+		for _, t := range s.countryTagPair[countryCode] {
+			if t == tag {
 				// Tag already exists
 				return
 			}
 		}
-	}
-
-	if b.countryTagPair == nil {
-		b.countryTagPair = make(map[string][]language.Tag)
-	}
-	b.countryTagPair[countryCode] = append(b.countryTagPair[countryCode], tag)
-	b.matcher = language.NewMatcher(b.countryTagPair[countryCode])
+		s.countryTagPair[countryCode] = append(s.countryTagPair[countryCode], tag)
+		s.matchers[countryCode] = language.NewMatcher(s.countryTagPair[countryCode])
+	})
 }
 
 // LanguageTags returns the list of language tags
 // of all the translations loaded into the bundle
 func (b *Bundle) LanguageTags(countryCode string) []language.Tag {
-	return b.countryTagPair[countryCode]
+	return b.state.Load().countryTagPair[countryCode]
 }
 
-func (b *Bundle) getMessageTemplate(tag language.Tag, id, countryCode string) *MessageTemplate {
-	key := b.messageTemplateKey(countryCode, tag)
-	templates := b.messageTemplates[key]
-	if templates == nil {
-		return nil
+// getMessageTemplate looks up id under countryCode and tag, then walks
+// any fallback chain configured for countryCode via SetFallbackChain
+// until it finds a template or runs out of steps.
+func (b *Bundle) getMessageTemplate(tag language.Tag, id, countryCode string) Template {
+	if tmpl := b.lookupMessageTemplate(countryCode, tag, id); tmpl != nil {
+		return tmpl
+	}
+	for _, step := range b.fallbackChains[countryCode] {
+		stepCountry, stepTag := b.resolveFallbackStep(step)
+		if tmpl := b.lookupMessageTemplate(stepCountry, stepTag, id); tmpl != nil {
+			return tmpl
+		}
 	}
-	return templates[id]
+	return nil
 }
 
 func (b *Bundle) messageTemplateKey(countryCode string, tag language.Tag) string {
 	return countryCode + "-" + tag.String()
 }
+
+// mutateState builds the next bundleState from a shallow copy of the
+// current one, lets fn apply its changes to the copy, then publishes it
+// with a single atomic store. Every map that fn may touch is copied up
+// front so that a reader holding the old state never sees a mutation
+// made against the new one.
+//
+// stateMu serializes the load-copy-store sequence across concurrent
+// callers, so two writers racing each other (for example RegisterSource's
+// one refresh goroutine per source) merge their changes instead of the
+// second Store silently discarding the first writer's work. Readers never
+// take stateMu; they always go through the atomic.Pointer directly.
+func (b *Bundle) mutateState(fn func(s *bundleState)) {
+	b.stateMu.Lock()
+	defer b.stateMu.Unlock()
+	old := b.state.Load()
+	next := &bundleState{
+		messageTemplates: make(map[string]map[string]Template, len(old.messageTemplates)),
+		countryTagPair:   make(map[string][]language.Tag, len(old.countryTagPair)),
+		matchers:         make(map[string]language.Matcher, len(old.matchers)),
+	}
+	for key, templates := range old.messageTemplates {
+		copied := make(map[string]Template, len(templates))
+		for id, mt := range templates {
+			copied[id] = mt
+		}
+		next.messageTemplates[key] = copied
+	}
+	for countryCode, tags := range old.countryTagPair {
+		next.countryTagPair[countryCode] = append([]language.Tag{}, tags...)
+	}
+	for countryCode, matcher := range old.matchers {
+		next.matchers[countryCode] = matcher
+	}
+	fn(next)
+	b.state.Store(next)
+}
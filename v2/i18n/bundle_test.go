@@ -0,0 +1,42 @@
+package i18n
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+// TestConcurrentAddMessagesDoesNotLoseWrites guards against mutateState's
+// load-copy-store sequence racing itself: without serializing concurrent
+// writers, the second Store of two racing AddMessages calls discards the
+// first writer's changes entirely, which is exactly the pattern
+// RegisterSource's one refresh goroutine per source produces.
+func TestConcurrentAddMessagesDoesNotLoseWrites(t *testing.T) {
+	b := NewBundle("US", language.English)
+	const n = 2000
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			id := fmt.Sprintf("msg-%d", i)
+			if err := b.AddMessages("US", language.English, &Message{ID: id, Other: "x"}); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	found := 0
+	for i := 0; i < n; i++ {
+		id := fmt.Sprintf("msg-%d", i)
+		if b.getMessageTemplate(language.English, id, "US") != nil {
+			found++
+		}
+	}
+	if found != n {
+		t.Fatalf("found %d/%d messages after concurrent AddMessages", found, n)
+	}
+}
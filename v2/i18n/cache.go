@@ -0,0 +1,41 @@
+package i18n
+
+import "sync"
+
+// Store is a small key/value cache RegisterSource uses so that a
+// MessageSource being unreachable at startup doesn't block loading. Its
+// shape mirrors what a BoltDB bucket or a Redis client already expose, so
+// either can back it directly.
+type Store interface {
+	// Get returns the value for key and whether it was present.
+	Get(key string) ([]byte, bool)
+	// Set persists value under key.
+	Set(key string, value []byte) error
+}
+
+// memStore is the default Store: an in-process map. It does not survive a
+// restart, so cold starts still need the remote reachable unless a
+// durable Store is supplied via Bundle.SetCacheStore.
+type memStore struct {
+	mu   sync.RWMutex
+	data map[string][]byte
+}
+
+// NewMemStore returns a Store backed by an in-memory map.
+func NewMemStore() Store {
+	return &memStore{data: map[string][]byte{}}
+}
+
+func (s *memStore) Get(key string) ([]byte, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.data[key]
+	return v, ok
+}
+
+func (s *memStore) Set(key string, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[key] = value
+	return nil
+}
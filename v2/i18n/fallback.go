@@ -0,0 +1,99 @@
+package i18n
+
+import "golang.org/x/text/language"
+
+// AnyCountry is the FallbackStep.CountryCode sentinel for a catalog
+// shared across every country, for example a "*/en" step that GB/en and
+// US/en both fall back to instead of duplicating every string.
+const AnyCountry = "*"
+
+// DefaultLanguage is the FallbackStep.Tag sentinel for falling back to
+// the bundle's default language within a country, for example a
+// "TR/tr(default)" step at the end of a chain.
+var DefaultLanguage = language.Tag{}
+
+// FallbackStep is one entry in a country's fallback chain: a
+// (country code, language tag) pair to retry when a more specific
+// lookup finds no template. Use AnyCountry as CountryCode and
+// DefaultLanguage as Tag for the sentinel cases described above.
+type FallbackStep struct {
+	CountryCode string
+	Tag         language.Tag
+}
+
+// SetFallbackChain configures the chain of (country, language) pairs
+// Bundle tries, in order, when getMessageTemplate finds no template for
+// countryCode under the language actually requested. Without a
+// configured chain, a missing template simply means the message is not
+// found.
+//
+// For example, a deployment can define
+//
+//	bundle.SetFallbackChain("GB", []i18n.FallbackStep{
+//		{CountryCode: i18n.AnyCountry, Tag: language.English},
+//		{CountryCode: "TR", Tag: i18n.DefaultLanguage},
+//	})
+//
+// so that GB/en falls back to a shared English catalog and then to TR's
+// catalog in the bundle's default language, without duplicating every
+// string across country files.
+func (b *Bundle) SetFallbackChain(countryCode string, chain []FallbackStep) {
+	if b.fallbackChains == nil {
+		b.fallbackChains = map[string][]FallbackStep{}
+	}
+	b.fallbackChains[countryCode] = chain
+}
+
+// Merge copies every message template and language tag registered under
+// srcCountry into dstCountry, without removing anything dstCountry
+// already has. It is useful for deriving one country's catalog from
+// another, for example seeding a new market's catalog from an existing
+// one before its translators start customizing it.
+func (b *Bundle) Merge(srcCountry, dstCountry string) {
+	srcTags := b.state.Load().countryTagPair[srcCountry]
+	for _, tag := range srcTags {
+		b.addTag(dstCountry, tag)
+	}
+	b.mutateState(func(s *bundleState) {
+		for _, tag := range srcTags {
+			srcTemplates := s.messageTemplates[b.messageTemplateKey(srcCountry, tag)]
+			if srcTemplates == nil {
+				continue
+			}
+			dstKey := b.messageTemplateKey(dstCountry, tag)
+			if s.messageTemplates[dstKey] == nil {
+				s.messageTemplates[dstKey] = map[string]Template{}
+			}
+			for id, tmpl := range srcTemplates {
+				if _, exists := s.messageTemplates[dstKey][id]; exists {
+					continue
+				}
+				s.messageTemplates[dstKey][id] = tmpl
+			}
+		}
+	})
+}
+
+// lookupMessageTemplate returns the template registered for id under
+// countryCode and tag, or nil if there is none.
+func (b *Bundle) lookupMessageTemplate(countryCode string, tag language.Tag, id string) Template {
+	templates := b.state.Load().messageTemplates[b.messageTemplateKey(countryCode, tag)]
+	if templates == nil {
+		return nil
+	}
+	return templates[id]
+}
+
+// resolveFallbackStep substitutes step's sentinel values for the
+// concrete country code and tag they stand for.
+func (b *Bundle) resolveFallbackStep(step FallbackStep) (string, language.Tag) {
+	countryCode := step.CountryCode
+	if countryCode == AnyCountry {
+		countryCode = ""
+	}
+	tag := step.Tag
+	if tag == DefaultLanguage {
+		tag = b.defaultLanguage
+	}
+	return countryCode, tag
+}
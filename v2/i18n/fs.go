@@ -0,0 +1,138 @@
+package i18n
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// fsSource remembers a LoadFS call so that Watch can replay it whenever
+// the files it read change on disk.
+type fsSource struct {
+	fsys        fs.FS
+	pattern     string
+	countryCode string
+}
+
+// LoadFS walks fsys and loads every file whose base name matches pattern
+// (a path.Match-style glob, e.g. "active.*.toml") into the bundle for
+// countryCode. The language tag of each file is inferred the same way
+// LoadMessageFile infers it: from everything after the second to last "."
+// in the file name, but before the format.
+//
+// LoadFS is commonly paired with an embed.FS so that translations ship
+// inside the binary, or with os.DirFS so that a later Watch call can pick
+// up edits made to the files on disk.
+func (b *Bundle) LoadFS(fsys fs.FS, pattern, countryCode string) error {
+	b.sourcesMu.Lock()
+	b.sources = append(b.sources, fsSource{fsys: fsys, pattern: pattern, countryCode: countryCode})
+	b.sourcesMu.Unlock()
+	return b.loadFS(fsys, pattern, countryCode)
+}
+
+// MustLoadFS is similar to LoadFS except it panics if an error happens.
+func (b *Bundle) MustLoadFS(fsys fs.FS, pattern, countryCode string) {
+	if err := b.LoadFS(fsys, pattern, countryCode); err != nil {
+		panic(err)
+	}
+}
+
+// loadFS is the shared implementation behind LoadFS and Watch's reloads:
+// it fetches pattern's files from fsys through an FSSource and applies
+// whatever it finds to countryCode.
+func (b *Bundle) loadFS(fsys fs.FS, pattern, countryCode string) error {
+	files, err := NewFSSource(fsys, pattern, b.unmarshalFuncs).Fetch(context.Background())
+	if err != nil {
+		return err
+	}
+	return b.applyFiles(countryCode, files)
+}
+
+// Watch polls paths (files or directories) for modifications and, on any
+// change, reloads every source previously registered through LoadFS. A
+// directory is watched recursively: editing, adding, or removing any file
+// under it counts as a change, even though the directory entry's own
+// modification time doesn't change when a file inside it is overwritten
+// in place. It blocks until ctx is done or a reload returns an error, so
+// callers typically run it in its own goroutine, for example from a
+// SIGHUP handler that wants translation edits picked up without a
+// restart:
+//
+//	go bundle.Watch(ctx, "./lang")
+//
+// Reloads replace each matched template atomically (see mutateState), so
+// Watch is safe to run alongside Localizers that are concurrently calling
+// Localize.
+func (b *Bundle) Watch(ctx context.Context, paths ...string) error {
+	const pollInterval = time.Second
+
+	var mtimes map[string]time.Time
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			current := fileModTimes(paths)
+			changed := mtimes != nil && !sameModTimes(mtimes, current)
+			mtimes = current
+			if !changed {
+				continue
+			}
+			if err := b.reloadSources(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// fileModTimes returns the modification time of every regular file found
+// under paths, walking any path that is a directory so that Watch
+// notices an in-place edit to a file inside it - not just a change to the
+// directory entry itself, which a typical filesystem doesn't update for
+// that case. Paths (or entries under them) that can't be stat'd are
+// silently skipped, the same as a single missing path was before.
+func fileModTimes(paths []string) map[string]time.Time {
+	times := map[string]time.Time{}
+	for _, p := range paths {
+		filepath.Walk(p, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return nil
+			}
+			times[path] = info.ModTime()
+			return nil
+		})
+	}
+	return times
+}
+
+// sameModTimes reports whether a and b record the same set of files with
+// the same modification times.
+func sameModTimes(a, b map[string]time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for path, t := range a {
+		if bt, ok := b[path]; !ok || !bt.Equal(t) {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *Bundle) reloadSources() error {
+	b.sourcesMu.Lock()
+	sources := append([]fsSource{}, b.sources...)
+	b.sourcesMu.Unlock()
+
+	for _, src := range sources {
+		if err := b.loadFS(src.fsys, src.pattern, src.countryCode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package i18n
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+func newJSONBundle() *Bundle {
+	b := NewBundle("", language.English)
+	b.RegisterUnmarshalFunc("json", json.Unmarshal)
+	return b
+}
+
+func TestLoadFS(t *testing.T) {
+	b := newJSONBundle()
+	fsys := fstest.MapFS{
+		"active.en.json": {Data: []byte(`{"hello": {"other": "Hello"}}`)},
+	}
+	if err := b.LoadFS(fsys, "active.*.json", "US"); err != nil {
+		t.Fatal(err)
+	}
+	if tmpl := b.getMessageTemplate(language.English, "hello", "US"); tmpl == nil {
+		t.Fatal("expected a template for \"hello\" after LoadFS")
+	}
+}
+
+// TestLoadFSConcurrentWithReload guards against a data race between LoadFS
+// appending to b.sources and reloadSources (driven by Watch) ranging over
+// it concurrently; run with -race.
+func TestLoadFSConcurrentWithReload(t *testing.T) {
+	b := newJSONBundle()
+	fsys := fstest.MapFS{
+		"active.en.json": {Data: []byte(`{"hello": {"other": "Hello"}}`)},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(countryCode string) {
+			defer wg.Done()
+			if err := b.LoadFS(fsys, "active.*.json", countryCode); err != nil {
+				t.Error(err)
+			}
+		}(string(rune('A' + i)))
+	}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := b.reloadSources(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// TestWatchPicksUpInPlaceEdit drives Watch's polling loop against a real
+// directory on disk and overwrites a loaded file's content without
+// renaming or recreating it, the way an editor's "save" does. Rewriting a
+// file in place doesn't change its parent directory's own mtime, so this
+// only passes if Watch stats the files under the directory rather than
+// the directory entry itself.
+func TestWatchPicksUpInPlaceEdit(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "active.en.json")
+	if err := os.WriteFile(path, []byte(`{"hello": {"other": "Hello"}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	b := newJSONBundle()
+	if err := b.LoadFS(os.DirFS(dir), "active.*.json", "US"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- b.Watch(ctx, dir) }()
+
+	// Let Watch's first poll establish its baseline mtimes before editing,
+	// so the edit itself is what triggers the reload.
+	time.Sleep(1500 * time.Millisecond)
+	if err := os.WriteFile(path, []byte(`{"hello": {"other": "Hi there"}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		tmpl := b.getMessageTemplate(language.English, "hello", "US")
+		if tmpl != nil {
+			if out, err := tmpl.Execute(nil, ""); err == nil && out == "Hi there" {
+				cancel()
+				<-done
+				return
+			}
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	cancel()
+	<-done
+	t.Fatal("Watch did not pick up the in-place edit within the deadline")
+}
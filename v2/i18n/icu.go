@@ -0,0 +1,15 @@
+package i18n
+
+import (
+	"github.com/abdullahskartal/go-i18n/v2/internal/icu"
+
+	"golang.org/x/text/language"
+)
+
+// ICUTemplateParser parses ICU MessageFormat source, for example
+// "{count, plural, one {# item} other {# items}}", into a Template. Pass
+// it to Bundle.SetTemplateParser to use ICU syntax instead of the
+// default Go text/template syntax.
+func ICUTemplateParser(src string, tag language.Tag) (Template, error) {
+	return icu.Parse(src, tag)
+}
@@ -0,0 +1,180 @@
+package i18n
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/abdullahskartal/go-i18n/v2/internal/plural"
+
+	"golang.org/x/text/language"
+)
+
+// Localizer renders messages for a set of preferred languages and a
+// country code.
+type Localizer struct {
+	bundle      *Bundle
+	tags        []language.Tag
+	countryCode string
+}
+
+// NewLocalizer returns a new Localizer that looks up messages in bundle
+// using the languages in langs, falling back to bundle's default
+// language. Each lang may be a single language tag ("en") or an
+// Accept-Language header value ("en-US,en;q=0.9,tr;q=0.8").
+func NewLocalizer(bundle *Bundle, langs ...string) *Localizer {
+	var tags []language.Tag
+	for _, lang := range langs {
+		parsed, _, err := language.ParseAcceptLanguage(lang)
+		if err != nil {
+			if tag, err := language.Parse(lang); err == nil {
+				tags = append(tags, tag)
+			}
+			continue
+		}
+		tags = append(tags, parsed...)
+	}
+	tags = append(tags, bundle.defaultLanguage)
+	return &Localizer{bundle: bundle, tags: tags}
+}
+
+// SetCountryCode sets the country code the Localizer looks up messages
+// under. It must be called before Localize if the bundle's messages were
+// loaded under a country code other than the empty string.
+func (l *Localizer) SetCountryCode(countryCode string) {
+	l.countryCode = countryCode
+}
+
+// LocalizeConfig configures a call to Localizer.Localize.
+type LocalizeConfig struct {
+	// MessageID is the id of the message to look up. Either MessageID or
+	// DefaultMessage must be set.
+	MessageID string
+
+	// DefaultMessage is used if MessageID is empty, or if no message with
+	// that id is found for any of the Localizer's languages.
+	DefaultMessage *Message
+
+	// TemplateData is the data passed to the message's template.
+	TemplateData interface{}
+
+	// PluralCount determines the CLDR plural form used to render the
+	// message, if it has plural content.
+	PluralCount interface{}
+
+	// PluralOrdinal selects the message's Ordinal content instead of its
+	// cardinal plural content, using PluralCount's ordinal plural
+	// category ("1st", "2nd", "3rd", ...).
+	PluralOrdinal bool
+
+	// SelectKey selects the message's Select content by key, typically a
+	// grammatical gender.
+	SelectKey string
+}
+
+// Localize renders the message described by cfg for the Localizer's
+// languages and country code.
+func (l *Localizer) Localize(cfg *LocalizeConfig) (string, error) {
+	id := cfg.MessageID
+	if id == "" && cfg.DefaultMessage != nil {
+		id = cfg.DefaultMessage.ID
+	}
+	if id == "" {
+		return "", fmt.Errorf("i18n: MessageID or DefaultMessage.ID is required")
+	}
+
+	tag, tmpl := l.resolveTemplate(id, cfg.DefaultMessage)
+	if tmpl == nil {
+		return "", fmt.Errorf("i18n: message %q not found", id)
+	}
+
+	if cfg.SelectKey != "" {
+		se, ok := tmpl.(SelectExecutor)
+		if !ok {
+			return "", fmt.Errorf("i18n: message %q does not support SelectKey", id)
+		}
+		return se.ExecuteSelect(cfg.TemplateData, cfg.SelectKey)
+	}
+
+	pluralForm := plural.Other
+	if cfg.PluralCount != nil {
+		n, err := pluralCountAsFloat(cfg.PluralCount)
+		if err != nil {
+			return "", err
+		}
+		if cfg.PluralOrdinal {
+			pluralForm = l.bundle.ordinalRules.OrdinalForm(tag, n)
+		} else if rule := l.bundle.pluralRules.Rule(tag); rule != nil {
+			pluralForm = rule.PluralFormFunc(plural.NewOperands(n))
+		}
+	}
+
+	if cfg.PluralOrdinal {
+		if oe, ok := tmpl.(OrdinalExecutor); ok {
+			return oe.ExecuteOrdinal(cfg.TemplateData, pluralForm)
+		}
+	}
+	return tmpl.Execute(cfg.TemplateData, pluralForm)
+}
+
+// MustLocalize is similar to Localize except it panics if an error happens.
+func (l *Localizer) MustLocalize(cfg *LocalizeConfig) string {
+	s, err := l.Localize(cfg)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// resolveTemplate returns a template for id under l's country code, along
+// with the language tag it was found under. It first negotiates among l's
+// preferred languages using the bundle's language.Matcher for that
+// country, so a preferred tag like "en-US" resolves against a loaded "en"
+// catalog even though the two don't match exactly; then it falls back to
+// an exact match per preferred tag, in preference order, for any tag the
+// matcher didn't already try. If none is found, it falls back to a
+// template built from defaultMessage under the bundle's default language.
+func (l *Localizer) resolveTemplate(id string, defaultMessage *Message) (language.Tag, Template) {
+	state := l.bundle.state.Load()
+	if matcher := state.matchers[l.countryCode]; matcher != nil {
+		// Match's returned tag may carry extensions (e.g. "en-u-rg-uszzzz"),
+		// so look up the loaded tag it matched by index instead.
+		if _, index, conf := matcher.Match(l.tags...); conf != language.No {
+			tag := state.countryTagPair[l.countryCode][index]
+			if tmpl := l.bundle.getMessageTemplate(tag, id, l.countryCode); tmpl != nil {
+				return tag, tmpl
+			}
+		}
+	}
+	for _, tag := range l.tags {
+		if tmpl := l.bundle.getMessageTemplate(tag, id, l.countryCode); tmpl != nil {
+			return tag, tmpl
+		}
+	}
+	if defaultMessage == nil {
+		return language.Tag{}, nil
+	}
+	tmpl, err := l.bundle.newTemplate(defaultMessage, l.bundle.defaultLanguage)
+	if err != nil {
+		return language.Tag{}, nil
+	}
+	return l.bundle.defaultLanguage, tmpl
+}
+
+func pluralCountAsFloat(v interface{}) (float64, error) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), nil
+	case int32:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	case float32:
+		return float64(n), nil
+	case float64:
+		return n, nil
+	case string:
+		return strconv.ParseFloat(n, 64)
+	default:
+		return 0, fmt.Errorf("i18n: unsupported PluralCount type %T", v)
+	}
+}
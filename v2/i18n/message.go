@@ -0,0 +1,53 @@
+package i18n
+
+// Message is a template for a translated string.
+type Message struct {
+	// ID uniquely identifies the message.
+	ID string
+
+	// Description describes the message to give additional context to translators.
+	Description string `json:",omitempty" toml:",omitempty" yaml:",omitempty"`
+
+	// Hash uniquely identifies the translatable content of the message.
+	// goi18n merge uses it to detect when a source message has changed
+	// since a translation of it was made.
+	Hash string `json:",omitempty" toml:",omitempty" yaml:",omitempty"`
+
+	// LeftDelim is the Go template left delimiter. Defaults to "{{".
+	LeftDelim string `json:",omitempty" toml:",omitempty" yaml:",omitempty"`
+
+	// RightDelim is the Go template right delimiter. Defaults to "}}".
+	RightDelim string `json:",omitempty" toml:",omitempty" yaml:",omitempty"`
+
+	// Zero is the content of the message for the CLDR plural form "zero".
+	Zero string `json:",omitempty" toml:",omitempty" yaml:",omitempty"`
+
+	// One is the content of the message for the CLDR plural form "one".
+	One string `json:",omitempty" toml:",omitempty" yaml:",omitempty"`
+
+	// Two is the content of the message for the CLDR plural form "two".
+	Two string `json:",omitempty" toml:",omitempty" yaml:",omitempty"`
+
+	// Few is the content of the message for the CLDR plural form "few".
+	Few string `json:",omitempty" toml:",omitempty" yaml:",omitempty"`
+
+	// Many is the content of the message for the CLDR plural form "many".
+	Many string `json:",omitempty" toml:",omitempty" yaml:",omitempty"`
+
+	// Other is the content of the message for the CLDR plural form
+	// "other". It is also the fallback used when a more specific plural
+	// form has no content.
+	Other string `json:",omitempty" toml:",omitempty" yaml:",omitempty"`
+
+	// Ordinal contains this message's content for CLDR ordinal plural
+	// categories ("1st", "2nd", "3rd", ...), keyed by category ("one",
+	// "two", "few", "other", ...). Use it with LocalizeConfig.PluralOrdinal.
+	// Unlike the cardinal fields above, most languages only need a
+	// handful of these categories.
+	Ordinal map[string]string `json:",omitempty" toml:",omitempty" yaml:",omitempty"`
+
+	// Select contains this message's content keyed by an arbitrary
+	// string, typically a grammatical gender ("male", "female", "other").
+	// Use it with LocalizeConfig.SelectKey.
+	Select map[string]string `json:",omitempty" toml:",omitempty" yaml:",omitempty"`
+}
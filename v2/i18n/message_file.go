@@ -0,0 +1,61 @@
+package i18n
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// MessageFile represents a parsed message file.
+type MessageFile struct {
+	Path     string
+	Tag      language.Tag
+	Format   string
+	Messages []*Message
+}
+
+// ParseMessageFileBytes parses the bytes in buf as a message file.
+//
+// The format of the file is everything after the last ".".
+//
+// The language tag of the file is everything after the second to last "."
+// or after the last path separator, but before the format.
+func ParseMessageFileBytes(buf []byte, path string, unmarshalFuncs map[string]UnmarshalFunc) (*MessageFile, error) {
+	format := strings.TrimPrefix(filepath.Ext(path), ".")
+	unmarshalFunc, ok := unmarshalFuncs[format]
+	if !ok {
+		return nil, fmt.Errorf("no unmarshal function registered for format %q", format)
+	}
+
+	base := strings.TrimSuffix(filepath.Base(path), "."+format)
+	langStr := base
+	if i := strings.LastIndex(base, "."); i >= 0 {
+		langStr = base[i+1:]
+	}
+	tag, err := language.Parse(langStr)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	var raw map[string]*Message
+	if err := unmarshalFunc(buf, &raw); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	messages := make([]*Message, 0, len(raw))
+	for id, m := range raw {
+		if m.ID == "" {
+			m.ID = id
+		}
+		messages = append(messages, m)
+	}
+
+	return &MessageFile{
+		Path:     path,
+		Tag:      tag,
+		Format:   format,
+		Messages: messages,
+	}, nil
+}
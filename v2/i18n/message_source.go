@@ -0,0 +1,177 @@
+package i18n
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Event is published on the channel returned by a Subscriber's Subscribe
+// method whenever its backing translations change.
+type Event struct {
+	Files []*MessageFile
+	Err   error
+}
+
+// MessageSource produces the message files for a single country. It
+// generalizes LoadMessageFile/ParseMessageFileBytes so translations can
+// come from somewhere other than the local filesystem, e.g. a central
+// translation-management service.
+type MessageSource interface {
+	Fetch(ctx context.Context) ([]*MessageFile, error)
+}
+
+// Subscriber is implemented by MessageSources that can push updates
+// instead of being polled. RegisterSource prefers Subscribe over polling
+// when a source implements it.
+type Subscriber interface {
+	Subscribe(ctx context.Context) (<-chan Event, error)
+}
+
+// defaultRefreshInterval is how often RegisterSource polls a
+// MessageSource that does not implement Subscriber.
+const defaultRefreshInterval = 30 * time.Second
+
+// SetCacheStore configures the Store RegisterSource uses to survive a
+// MessageSource being unreachable on startup. It may be called at any
+// time, including concurrently with RegisterSource; if it is never
+// called, RegisterSource falls back to an in-memory Store that does not
+// survive a process restart.
+func (b *Bundle) SetCacheStore(store Store) {
+	b.cacheMu.Lock()
+	defer b.cacheMu.Unlock()
+	b.cache = store
+}
+
+// getCache returns the configured Store, lazily creating the in-memory
+// default the first time it's needed. It's the only code path allowed to
+// read or write b.cache, so that RegisterSource calls racing each other
+// (for example registering sources for two countries at startup) and a
+// concurrent SetCacheStore can't tear the field.
+func (b *Bundle) getCache() Store {
+	b.cacheMu.Lock()
+	defer b.cacheMu.Unlock()
+	if b.cache == nil {
+		b.cache = NewMemStore()
+	}
+	return b.cache
+}
+
+// RegisterSource fetches countryCode's messages from src, adds them to
+// the bundle, and starts a background goroutine that keeps them fresh
+// until ctx is done: it calls src.Subscribe if src implements Subscriber,
+// otherwise it polls src.Fetch every 30 seconds.
+//
+// If the initial Fetch fails, RegisterSource falls back to the last
+// successful fetch recorded in the configured Store (see SetCacheStore),
+// so a cold start doesn't require the remote to be reachable.
+func (b *Bundle) RegisterSource(ctx context.Context, countryCode string, src MessageSource) error {
+	cache := b.getCache()
+	key := sourceCacheKey(countryCode, src)
+
+	files, err := src.Fetch(ctx)
+	if err != nil {
+		cached, ok := cache.Get(key)
+		if !ok {
+			return fmt.Errorf("fetch from %T for %s: %w (no cached copy available)", src, countryCode, err)
+		}
+		if files, err = decodeCachedFiles(cached); err != nil {
+			return err
+		}
+	} else {
+		b.cacheFiles(key, files)
+	}
+
+	if err := b.applyFiles(countryCode, files); err != nil {
+		return err
+	}
+
+	go b.runSource(ctx, key, countryCode, src)
+	return nil
+}
+
+func (b *Bundle) applyFiles(countryCode string, files []*MessageFile) error {
+	for _, mf := range files {
+		if err := b.AddMessages(countryCode, mf.Tag, mf.Messages...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Bundle) runSource(ctx context.Context, key, countryCode string, src MessageSource) {
+	if sub, ok := src.(Subscriber); ok {
+		b.runSubscriber(ctx, key, countryCode, sub)
+		return
+	}
+	b.pollSource(ctx, key, countryCode, src)
+}
+
+func (b *Bundle) runSubscriber(ctx context.Context, key, countryCode string, sub Subscriber) {
+	events, err := sub.Subscribe(ctx)
+	if err != nil {
+		return
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			if ev.Err != nil {
+				continue
+			}
+			if err := b.applyFiles(countryCode, ev.Files); err != nil {
+				continue
+			}
+			b.cacheFiles(key, ev.Files)
+		}
+	}
+}
+
+func (b *Bundle) pollSource(ctx context.Context, key, countryCode string, src MessageSource) {
+	ticker := time.NewTicker(defaultRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			files, err := src.Fetch(ctx)
+			if err != nil {
+				continue
+			}
+			if err := b.applyFiles(countryCode, files); err != nil {
+				continue
+			}
+			b.cacheFiles(key, files)
+		}
+	}
+}
+
+func (b *Bundle) cacheFiles(key string, files []*MessageFile) {
+	encoded, err := json.Marshal(files)
+	if err != nil {
+		return
+	}
+	_ = b.getCache().Set(key, encoded)
+}
+
+func decodeCachedFiles(data []byte) ([]*MessageFile, error) {
+	var files []*MessageFile
+	if err := json.Unmarshal(data, &files); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// sourceCacheKey identifies a (countryCode, src) pair in the Store. It is
+// good enough as long as callers register at most one source of a given
+// Go type per country; register a type implementing a distinct cache key
+// if that assumption doesn't hold for your deployment.
+func sourceCacheKey(countryCode string, src MessageSource) string {
+	return fmt.Sprintf("%s:%T", countryCode, src)
+}
@@ -0,0 +1,245 @@
+package i18n
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// fakeSource is a MessageSource whose Fetch is controlled by a function,
+// for exercising RegisterSource's caching and fallback behavior without a
+// real backend.
+type fakeSource struct {
+	fetch func(ctx context.Context) ([]*MessageFile, error)
+	calls int32
+}
+
+func (s *fakeSource) Fetch(ctx context.Context) ([]*MessageFile, error) {
+	atomic.AddInt32(&s.calls, 1)
+	return s.fetch(ctx)
+}
+
+// fakeSubscriber additionally implements Subscriber, so RegisterSource
+// should prefer Subscribe over polling Fetch for it.
+type fakeSubscriber struct {
+	fakeSource
+	subscribed int32
+	events     chan Event
+}
+
+func (s *fakeSubscriber) Subscribe(ctx context.Context) (<-chan Event, error) {
+	atomic.AddInt32(&s.subscribed, 1)
+	return s.events, nil
+}
+
+func helloFile(text string) []*MessageFile {
+	return []*MessageFile{{
+		Path:     "active.en.json",
+		Tag:      language.English,
+		Format:   "json",
+		Messages: []*Message{{ID: "hello", Other: text}},
+	}}
+}
+
+func TestRegisterSourceFetchFailureFallsBackToCache(t *testing.T) {
+	b := newJSONBundle()
+	cache := NewMemStore()
+	b.SetCacheStore(cache)
+
+	src := &fakeSource{}
+	key := sourceCacheKey("US", src)
+	encoded, err := json.Marshal(helloFile("Hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Set(key, encoded); err != nil {
+		t.Fatal(err)
+	}
+	src.fetch = func(ctx context.Context) ([]*MessageFile, error) {
+		return nil, errors.New("backend unreachable")
+	}
+
+	if err := b.RegisterSource(context.Background(), "US", src); err != nil {
+		t.Fatalf("RegisterSource: %v", err)
+	}
+
+	tmpl := b.getMessageTemplate(language.English, "hello", "US")
+	if tmpl == nil {
+		t.Fatal("expected the cached copy to be applied when Fetch fails")
+	}
+	if out, err := tmpl.Execute(nil, ""); err != nil || out != "Hello" {
+		t.Fatalf("got (%q, %v), want (\"Hello\", nil)", out, err)
+	}
+}
+
+func TestRegisterSourceFetchFailureNoCacheReturnsError(t *testing.T) {
+	b := newJSONBundle()
+	src := &fakeSource{fetch: func(ctx context.Context) ([]*MessageFile, error) {
+		return nil, errors.New("backend unreachable")
+	}}
+	if err := b.RegisterSource(context.Background(), "US", src); err == nil {
+		t.Fatal("expected an error when Fetch fails and there's no cached copy")
+	}
+}
+
+// TestRegisterSourceConcurrentDoesNotRaceCache registers sources for
+// several countries at once. Run with -race: SetCacheStore and the
+// lazily-created default Store are both read and written by RegisterSource,
+// so registering sources for two countries at startup is exactly the
+// pattern that used to race on b.cache.
+func TestRegisterSourceConcurrentDoesNotRaceCache(t *testing.T) {
+	b := newJSONBundle()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(countryCode string) {
+			defer wg.Done()
+			src := &fakeSource{fetch: func(ctx context.Context) ([]*MessageFile, error) {
+				return helloFile("Hello"), nil
+			}}
+			if err := b.RegisterSource(context.Background(), countryCode, src); err != nil {
+				t.Error(err)
+			}
+		}(string(rune('A' + i)))
+	}
+	wg.Wait()
+}
+
+func TestRegisterSourcePrefersSubscribeOverPolling(t *testing.T) {
+	b := newJSONBundle()
+	events := make(chan Event)
+	src := &fakeSubscriber{
+		fakeSource: fakeSource{fetch: func(ctx context.Context) ([]*MessageFile, error) {
+			return helloFile("Hello"), nil
+		}},
+		events: events,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := b.RegisterSource(ctx, "US", src); err != nil {
+		t.Fatalf("RegisterSource: %v", err)
+	}
+
+	events <- Event{Files: helloFile("Hi there")}
+
+	deadline := time.Now().Add(2 * time.Second)
+	var tmpl Template
+	for time.Now().Before(deadline) {
+		if tmpl = b.getMessageTemplate(language.English, "hello", "US"); tmpl != nil {
+			if out, err := tmpl.Execute(nil, ""); err == nil && out == "Hi there" {
+				break
+			}
+			tmpl = nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if tmpl == nil {
+		t.Fatal("RegisterSource did not apply the event published over Subscribe")
+	}
+
+	close(events)
+	if atomic.LoadInt32(&src.subscribed) != 1 {
+		t.Fatalf("subscribed = %d, want 1", src.subscribed)
+	}
+	if calls := atomic.LoadInt32(&src.calls); calls != 1 {
+		t.Fatalf("Fetch was called %d times, want 1 (the initial RegisterSource fetch, no polling)", calls)
+	}
+}
+
+// TestHTTPSourceRevalidatesWithETag drives HTTPSource against a real
+// httptest.Server: the first Fetch should send no If-None-Match and store
+// the returned ETag, and a second Fetch should send that ETag back and
+// reuse the cached result on a 304 rather than re-parsing a new body.
+func TestHTTPSourceRevalidatesWithETag(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		if n == 1 {
+			if inm := r.Header.Get("If-None-Match"); inm != "" {
+				t.Errorf("first request sent If-None-Match %q, want none", inm)
+			}
+			w.Header().Set("ETag", `"v1"`)
+			w.Write([]byte(`{"hello": {"other": "Hello"}}`))
+			return
+		}
+		if inm := r.Header.Get("If-None-Match"); inm != `"v1"` {
+			t.Errorf("second request sent If-None-Match %q, want %q", inm, `"v1"`)
+		}
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer srv.Close()
+
+	src := NewHTTPSource(srv.URL, "active.en.json", map[string]UnmarshalFunc{"json": json.Unmarshal})
+
+	first, err := src.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("first Fetch: %v", err)
+	}
+	second, err := src.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("second Fetch: %v", err)
+	}
+	if len(second) != 1 || second[0].Messages[0].ID != "hello" {
+		t.Fatalf("second Fetch = %+v, want the cached file from the first Fetch", second)
+	}
+	if len(first) != len(second) || first[0] != second[0] {
+		t.Fatalf("second Fetch returned a different []*MessageFile than the cached one from the first Fetch")
+	}
+	if atomic.LoadInt32(&requests) != 2 {
+		t.Fatalf("server saw %d requests, want 2", requests)
+	}
+}
+
+// TestHTTPSourceFetchFailureFallsBackToCache combines HTTPSource with
+// RegisterSource: once the server has been reachable once, a later outage
+// should still leave the bundle serving the last good translations.
+func TestHTTPSourceFetchFailureFallsBackToCache(t *testing.T) {
+	up := int32(1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&up) == 0 {
+			http.Error(w, "boom", http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"hello": {"other": "Hello"}}`))
+	}))
+	defer srv.Close()
+
+	b := newJSONBundle()
+	src := NewHTTPSource(srv.URL, "active.en.json", map[string]UnmarshalFunc{"json": json.Unmarshal})
+	if err := b.RegisterSource(context.Background(), "US", src); err != nil {
+		t.Fatalf("RegisterSource while up: %v", err)
+	}
+
+	atomic.StoreInt32(&up, 0)
+	// A second HTTPSource against the same URL simulates a fresh process
+	// restart: its own in-memory ETag/last fields are gone, so the only
+	// way it can succeed against a down server is RegisterSource falling
+	// back to the cache the first registration populated.
+	restarted := NewHTTPSource(srv.URL, "active.en.json", map[string]UnmarshalFunc{"json": json.Unmarshal})
+	b2 := newJSONBundle()
+	b2.SetCacheStore(mustShareCache(t, b))
+	if err := b2.RegisterSource(context.Background(), "US", restarted); err != nil {
+		t.Fatalf("RegisterSource while down: %v", err)
+	}
+	tmpl := b2.getMessageTemplate(language.English, "hello", "US")
+	if tmpl == nil {
+		t.Fatal("expected the cached copy to be applied when the server is down")
+	}
+}
+
+// mustShareCache extracts the Store b populated via RegisterSource so a
+// second bundle can be seeded with the same cached entries, simulating a
+// durable Store (e.g. BoltDB) surviving a process restart.
+func mustShareCache(t *testing.T, b *Bundle) Store {
+	t.Helper()
+	return b.getCache()
+}
@@ -0,0 +1,124 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/abdullahskartal/go-i18n/v2/internal/plural"
+)
+
+// MessageTemplate is an executable template for a message: one Go
+// text/template per CLDR plural form the message defines content for,
+// plus one per ordinal plural category and select key it defines.
+type MessageTemplate struct {
+	Message         *Message
+	Template        map[plural.Form]*template.Template
+	OrdinalTemplate map[plural.Form]*template.Template
+	SelectTemplate  map[string]*template.Template
+}
+
+// NewMessageTemplate parses m's content for every plural form, ordinal
+// category, and select key it defines as a Go text/template. A form with
+// no content is left unset; Execute/ExecuteOrdinal/ExecuteSelect fall
+// back to the "other" form for those.
+func NewMessageTemplate(m *Message) *MessageTemplate {
+	mt := &MessageTemplate{
+		Message:         m,
+		Template:        map[plural.Form]*template.Template{},
+		OrdinalTemplate: map[plural.Form]*template.Template{},
+		SelectTemplate:  map[string]*template.Template{},
+	}
+	for form, src := range map[plural.Form]string{
+		plural.Zero:  m.Zero,
+		plural.One:   m.One,
+		plural.Two:   m.Two,
+		plural.Few:   m.Few,
+		plural.Many:  m.Many,
+		plural.Other: m.Other,
+	} {
+		if tmpl := mt.parse(src); tmpl != nil {
+			mt.Template[form] = tmpl
+		}
+	}
+	for category, src := range m.Ordinal {
+		if tmpl := mt.parse(src); tmpl != nil {
+			mt.OrdinalTemplate[plural.Form(category)] = tmpl
+		}
+	}
+	for key, src := range m.Select {
+		if tmpl := mt.parse(src); tmpl != nil {
+			mt.SelectTemplate[key] = tmpl
+		}
+	}
+	return mt
+}
+
+// parse parses src as a Go text/template using mt.Message's delimiters.
+// It returns nil for empty or invalid source.
+func (mt *MessageTemplate) parse(src string) *template.Template {
+	if src == "" {
+		return nil
+	}
+	tmpl, err := template.New(mt.Message.ID).Delims(mt.Message.LeftDelim, mt.Message.RightDelim).Parse(src)
+	if err != nil {
+		return nil
+	}
+	return tmpl
+}
+
+// Execute implements Template. It renders the template for pluralForm,
+// falling back to the "other" form if pluralForm has no content.
+func (mt *MessageTemplate) Execute(data interface{}, pluralForm plural.Form) (string, error) {
+	tmpl := mt.Template[pluralForm]
+	if tmpl == nil {
+		tmpl = mt.Template[plural.Other]
+	}
+	if tmpl == nil {
+		return "", fmt.Errorf("message %q has no template for plural form %q", mt.Message.ID, pluralForm)
+	}
+	return execute(tmpl, data)
+}
+
+// ExecuteOrdinal implements OrdinalExecutor. It renders the message's
+// Ordinal content for ordinalForm, falling back to the "other" category
+// and then to the cardinal "other" form if the message has no ordinal
+// content at all.
+func (mt *MessageTemplate) ExecuteOrdinal(data interface{}, ordinalForm plural.Form) (string, error) {
+	tmpl := mt.OrdinalTemplate[ordinalForm]
+	if tmpl == nil {
+		tmpl = mt.OrdinalTemplate[plural.Other]
+	}
+	if tmpl == nil {
+		tmpl = mt.Template[plural.Other]
+	}
+	if tmpl == nil {
+		return "", fmt.Errorf("message %q has no template for ordinal form %q", mt.Message.ID, ordinalForm)
+	}
+	return execute(tmpl, data)
+}
+
+// ExecuteSelect implements SelectExecutor. It renders the message's
+// Select content for key, falling back to the "other" key and then to
+// the cardinal "other" form if the message has no select content at all.
+func (mt *MessageTemplate) ExecuteSelect(data interface{}, key string) (string, error) {
+	tmpl := mt.SelectTemplate[key]
+	if tmpl == nil {
+		tmpl = mt.SelectTemplate["other"]
+	}
+	if tmpl == nil {
+		tmpl = mt.Template[plural.Other]
+	}
+	if tmpl == nil {
+		return "", fmt.Errorf("message %q has no template for select key %q", mt.Message.ID, key)
+	}
+	return execute(tmpl, data)
+}
+
+func execute(tmpl *template.Template, data interface{}) (string, error) {
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,66 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+)
+
+// FSSource serves a country's messages from an fs.FS, matching files by a
+// path.Match-style pattern (e.g. "active.*.toml"). It is the MessageSource
+// counterpart of Bundle.LoadFS: pair it with Bundle.RegisterSource to have
+// an embedded or on-disk catalog refreshed through the same source
+// abstraction as a remote backend.
+type FSSource struct {
+	FS             fs.FS
+	Pattern        string
+	UnmarshalFuncs map[string]UnmarshalFunc
+}
+
+// NewFSSource returns an FSSource that reads files matching pattern out of
+// fsys, decoding them with unmarshalFuncs (the same map a Bundle builds up
+// via RegisterUnmarshalFunc).
+func NewFSSource(fsys fs.FS, pattern string, unmarshalFuncs map[string]UnmarshalFunc) *FSSource {
+	return &FSSource{FS: fsys, Pattern: pattern, UnmarshalFuncs: unmarshalFuncs}
+}
+
+// NewDirSource returns an FSSource rooted at dir on the local filesystem.
+func NewDirSource(dir, pattern string, unmarshalFuncs map[string]UnmarshalFunc) *FSSource {
+	return NewFSSource(os.DirFS(dir), pattern, unmarshalFuncs)
+}
+
+// Fetch implements MessageSource.
+func (s *FSSource) Fetch(ctx context.Context) ([]*MessageFile, error) {
+	var files []*MessageFile
+	err := fs.WalkDir(s.FS, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		matched, err := path.Match(s.Pattern, path.Base(p))
+		if err != nil {
+			return err
+		}
+		if !matched {
+			return nil
+		}
+		buf, err := fs.ReadFile(s.FS, p)
+		if err != nil {
+			return err
+		}
+		mf, err := ParseMessageFileBytes(buf, p, s.UnmarshalFuncs)
+		if err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+		files = append(files, mf)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
@@ -0,0 +1,70 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// HTTPSource fetches a single message file over HTTP(S), sending
+// If-None-Match with the ETag from the previous response so an unchanged
+// catalog costs a 304, answered with the previously parsed files, instead
+// of a full re-download.
+type HTTPSource struct {
+	URL            string
+	Path           string
+	Client         *http.Client
+	UnmarshalFuncs map[string]UnmarshalFunc
+
+	etag string
+	last []*MessageFile
+}
+
+// NewHTTPSource returns an HTTPSource that fetches url, treating its body
+// as a message file named path (used only to infer the language tag and
+// format, the same way LoadMessageFile does).
+func NewHTTPSource(url, path string, unmarshalFuncs map[string]UnmarshalFunc) *HTTPSource {
+	return &HTTPSource{URL: url, Path: path, Client: http.DefaultClient, UnmarshalFuncs: unmarshalFuncs}
+}
+
+// Fetch implements MessageSource.
+func (s *HTTPSource) Fetch(ctx context.Context) ([]*MessageFile, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if s.etag != "" {
+		req.Header.Set("If-None-Match", s.etag)
+	}
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return s.last, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch %s: unexpected status %s", s.URL, resp.Status)
+	}
+
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	mf, err := ParseMessageFileBytes(buf, s.Path, s.UnmarshalFuncs)
+	if err != nil {
+		return nil, err
+	}
+
+	s.etag = resp.Header.Get("ETag")
+	s.last = []*MessageFile{mf}
+	return s.last, nil
+}
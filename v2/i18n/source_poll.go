@@ -0,0 +1,51 @@
+package i18n
+
+import (
+	"context"
+	"time"
+)
+
+// PollingSource turns any MessageSource into a Subscriber by calling its
+// Fetch method on a fixed interval and publishing an Event with the
+// result. This is the shape a JSON or gRPC translation-management client
+// takes: the client only needs to implement Fetch, and PollingSource
+// supplies the push-like Subscribe behavior RegisterSource prefers.
+type PollingSource struct {
+	Source   MessageSource
+	Interval time.Duration
+}
+
+// NewPollingSource returns a PollingSource that calls src.Fetch every
+// interval.
+func NewPollingSource(src MessageSource, interval time.Duration) *PollingSource {
+	return &PollingSource{Source: src, Interval: interval}
+}
+
+// Fetch implements MessageSource by delegating to the wrapped source.
+func (p *PollingSource) Fetch(ctx context.Context) ([]*MessageFile, error) {
+	return p.Source.Fetch(ctx)
+}
+
+// Subscribe implements Subscriber.
+func (p *PollingSource) Subscribe(ctx context.Context) (<-chan Event, error) {
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(p.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				files, err := p.Source.Fetch(ctx)
+				select {
+				case events <- Event{Files: files, Err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return events, nil
+}
@@ -0,0 +1,61 @@
+package i18n
+
+import (
+	"github.com/abdullahskartal/go-i18n/v2/internal/plural"
+
+	"golang.org/x/text/language"
+)
+
+// Template is an executable message template. MessageTemplate implements
+// it for the default Go text/template syntax; SetTemplateParser lets a
+// Bundle build Templates with a different syntax instead, such as ICU
+// MessageFormat.
+type Template interface {
+	// Execute renders the template against data for the given plural
+	// form, falling back to the "other" form if the template has no
+	// content for pluralForm.
+	Execute(data interface{}, pluralForm plural.Form) (string, error)
+}
+
+// TemplateParser parses a message's template source into a Template for
+// tag. tag lets a parser like ICUTemplateParser pick the right CLDR
+// plural rule for any plural/selectordinal content in src.
+type TemplateParser func(src string, tag language.Tag) (Template, error)
+
+// OrdinalExecutor is implemented by Templates that keep ordinal plural
+// content (Message.Ordinal) separate from cardinal content. Localizer
+// uses it when LocalizeConfig.PluralOrdinal is set; Templates that don't
+// implement it - the ICU engine expresses ordinal selection natively via
+// selectordinal - just use Execute as normal.
+type OrdinalExecutor interface {
+	ExecuteOrdinal(data interface{}, ordinalForm plural.Form) (string, error)
+}
+
+// SelectExecutor is implemented by Templates that support select content
+// (Message.Select) keyed by an arbitrary string, typically gender.
+// Localizer uses it when LocalizeConfig.SelectKey is set.
+type SelectExecutor interface {
+	ExecuteSelect(data interface{}, key string) (string, error)
+}
+
+// SetTemplateParser overrides how the bundle builds a Template from a
+// message's content. By default every message is parsed as Go
+// text/template source, one template per CLDR plural form it defines
+// (see MessageTemplate); SetTemplateParser lets an application opt into a
+// different syntax, such as the ICU MessageFormat parser registered as
+// ICUTemplateParser, without changing how messages are loaded.
+//
+// When a parser is set, a message's Other field is treated as the
+// canonical template source and its other plural form fields are
+// ignored, since syntaxes like ICU MessageFormat express pluralization
+// within a single template rather than across fields.
+func (b *Bundle) SetTemplateParser(parser TemplateParser) {
+	b.templateParser = parser
+}
+
+func (b *Bundle) newTemplate(m *Message, tag language.Tag) (Template, error) {
+	if b.templateParser == nil {
+		return NewMessageTemplate(m), nil
+	}
+	return b.templateParser(m.Other, tag)
+}
@@ -0,0 +1,207 @@
+package icu
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abdullahskartal/go-i18n/v2/internal/plural"
+
+	"golang.org/x/text/language"
+)
+
+// cardinalRules and ordinalRules are the CLDR rules the evaluator uses
+// to pick a plural/selectordinal arm from a node's own argument value,
+// the same default tables Bundle uses for the Go text/template syntax.
+var (
+	cardinalRules = plural.DefaultRules()
+	ordinalRules  = plural.DefaultOrdinalRules()
+)
+
+// Template is a parsed ICU MessageFormat pattern for one language tag.
+type Template struct {
+	nodes nodes
+	tag   language.Tag
+}
+
+// Parse parses src as an ICU MessageFormat pattern for tag, for example:
+//
+//	{count, plural, one {# item} other {# items}}
+//
+// tag is used to pick the CLDR cardinal or ordinal plural rule for any
+// plural/selectordinal argument in src.
+func Parse(src string, tag language.Tag) (*Template, error) {
+	p := &parser{src: src}
+	n, err := p.parse(false)
+	if err != nil {
+		return nil, err
+	}
+	return &Template{nodes: n, tag: tag}, nil
+}
+
+// Execute renders the template against data, which must be a
+// map[string]interface{} of argument name to value (time.Time for date
+// arguments, a number for plural/number arguments, a string otherwise).
+//
+// Plural and selectordinal arguments pick their arm by an explicit "=N"
+// match first, then by evaluating their own argument's value - after
+// subtracting any offset:n - against the template's tag under
+// internal/plural's cardinal or ordinal rule, the same way a native ICU
+// MessageFormat implementation would. pluralForm is only used as a last
+// resort, for a node whose argument value can't be read from data.
+func (t *Template) Execute(data interface{}, pluralForm plural.Form) (string, error) {
+	values, _ := data.(map[string]interface{})
+	e := &evaluator{values: values, tag: t.tag, fallback: pluralForm}
+	return e.render(t.nodes, 0)
+}
+
+type evaluator struct {
+	values   map[string]interface{}
+	tag      language.Tag
+	fallback plural.Form
+}
+
+func (e *evaluator) render(ns nodes, pound float64) (string, error) {
+	var buf strings.Builder
+	for _, n := range ns {
+		s, err := e.renderNode(n, pound)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(s)
+	}
+	return buf.String(), nil
+}
+
+func (e *evaluator) renderNode(n node, pound float64) (string, error) {
+	switch n.kind {
+	case kindLiteral:
+		return n.lit, nil
+
+	case kindArgument:
+		if n.arg == "#" {
+			return formatNumber(pound), nil
+		}
+		v, ok := e.values[n.arg]
+		if !ok {
+			return "", nil
+		}
+		return fmt.Sprint(v), nil
+
+	case kindNumber:
+		v, ok := e.numberArg(n.arg)
+		if !ok {
+			return "", nil
+		}
+		return formatNumber(v), nil
+
+	case kindDate:
+		v, ok := e.values[n.arg]
+		if !ok {
+			return "", nil
+		}
+		t, ok := v.(time.Time)
+		if !ok {
+			return fmt.Sprint(v), nil
+		}
+		return formatDate(t, n.style), nil
+
+	case kindPlural, kindSelectOrdinal:
+		v, ok := e.numberArg(n.arg)
+		if !ok {
+			arm, ok := n.arms[string(e.fallback)]
+			if !ok {
+				arm, ok = n.arms[string(plural.Other)]
+			}
+			if !ok {
+				return "", fmt.Errorf("icu: argument %q has no matching arm", n.arg)
+			}
+			return e.render(arm, pound)
+		}
+		adjusted := v - float64(n.offset)
+		arm, ok := n.arms[exactKey(v)]
+		if !ok {
+			form := e.fallback
+			if n.kind == kindPlural {
+				form = cardinalRules.PluralForm(e.tag, adjusted)
+			} else {
+				form = ordinalRules.OrdinalForm(e.tag, adjusted)
+			}
+			arm, ok = n.arms[string(form)]
+		}
+		if !ok {
+			arm, ok = n.arms[string(plural.Other)]
+		}
+		if !ok {
+			return "", fmt.Errorf("icu: argument %q has no matching arm", n.arg)
+		}
+		return e.render(arm, adjusted)
+
+	case kindSelect:
+		key, _ := e.values[n.arg].(string)
+		arm, ok := n.arms[key]
+		if !ok {
+			arm, ok = n.arms[string(plural.Other)]
+		}
+		if !ok {
+			return "", fmt.Errorf("icu: argument %q has no matching arm", n.arg)
+		}
+		return e.render(arm, pound)
+
+	default:
+		return "", fmt.Errorf("icu: unhandled node")
+	}
+}
+
+func (e *evaluator) numberArg(name string) (float64, bool) {
+	v, ok := e.values[name]
+	if !ok {
+		return 0, false
+	}
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func exactKey(v float64) string {
+	if v == math.Trunc(v) {
+		return "=" + strconv.FormatInt(int64(v), 10)
+	}
+	return "=" + strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatNumber(v float64) string {
+	if v == math.Trunc(v) {
+		return strconv.FormatInt(int64(v), 10)
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatDate(t time.Time, style string) string {
+	switch style {
+	case "short":
+		return t.Format("1/2/06")
+	case "long":
+		return t.Format("January 2, 2006")
+	case "full":
+		return t.Format("Monday, January 2, 2006")
+	default: // "medium" or unspecified
+		return t.Format("Jan 2, 2006")
+	}
+}
@@ -0,0 +1,264 @@
+// Package icu parses ICU MessageFormat patterns
+// (https://unicode-org.github.io/icu/userguide/format_parse/messages/)
+// into an AST that eval.go can execute.
+package icu
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type kind int
+
+const (
+	kindLiteral kind = iota
+	kindArgument
+	kindPlural
+	kindSelect
+	kindSelectOrdinal
+	kindNumber
+	kindDate
+)
+
+// node is one element of a parsed pattern.
+type node struct {
+	kind   kind
+	arg    string // argument name; "#" for a plural substitution
+	lit    string // kindLiteral
+	style  string // kindNumber/kindDate
+	offset int    // kindPlural/kindSelectOrdinal: offset:n
+	arms   map[string]nodes
+}
+
+type nodes []node
+
+// parser is a recursive-descent parser over a pattern's source text.
+type parser struct {
+	src string
+	pos int
+}
+
+// parse parses src as the body of a message, stopping at an unescaped "}"
+// when inArm is true.
+func (p *parser) parse(inArm bool) (nodes, error) {
+	var out nodes
+	var lit strings.Builder
+	flush := func() {
+		if lit.Len() > 0 {
+			out = append(out, node{kind: kindLiteral, lit: lit.String()})
+			lit.Reset()
+		}
+	}
+
+	for p.pos < len(p.src) {
+		switch c := p.src[p.pos]; c {
+		case '{':
+			flush()
+			n, err := p.parseArgument()
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, n)
+		case '}':
+			if !inArm {
+				return nil, fmt.Errorf("icu: unexpected %q at offset %d", "}", p.pos)
+			}
+			flush()
+			p.pos++ // consume the arm's closing brace
+			return out, nil
+		case '\'':
+			p.pos++
+			if p.pos < len(p.src) && p.src[p.pos] == '\'' {
+				lit.WriteByte('\'')
+				p.pos++
+				continue
+			}
+			start := p.pos
+			for p.pos < len(p.src) && p.src[p.pos] != '\'' {
+				p.pos++
+			}
+			lit.WriteString(p.src[start:p.pos])
+			if p.pos < len(p.src) {
+				p.pos++
+			}
+		case '#':
+			flush()
+			out = append(out, node{kind: kindArgument, arg: "#"})
+			p.pos++
+		default:
+			lit.WriteByte(c)
+			p.pos++
+		}
+	}
+	if inArm {
+		return nil, fmt.Errorf("icu: unterminated argument")
+	}
+	flush()
+	return out, nil
+}
+
+// parseArgument parses a "{" ... "}" argument, having already flushed any
+// preceding literal text. p.pos points at the opening "{".
+func (p *parser) parseArgument() (node, error) {
+	start := p.pos
+	p.pos++ // consume '{'
+	name := p.parseToken()
+	p.skipSpace()
+	if p.peek() == '}' {
+		p.pos++
+		return node{kind: kindArgument, arg: name}, nil
+	}
+	if p.peek() != ',' {
+		return node{}, fmt.Errorf("icu: expected ',' after argument %q", name)
+	}
+	p.pos++
+	p.skipSpace()
+	typ := p.parseToken()
+	p.skipSpace()
+
+	switch typ {
+	case "plural", "selectordinal", "select":
+		n := node{arg: name, arms: map[string]nodes{}}
+		switch typ {
+		case "plural":
+			n.kind = kindPlural
+		case "selectordinal":
+			n.kind = kindSelectOrdinal
+		default:
+			n.kind = kindSelect
+		}
+		if p.peek() == ',' {
+			p.pos++
+			p.skipSpace()
+		}
+		if typ != "select" && strings.HasPrefix(p.src[p.pos:], "offset:") {
+			p.pos += len("offset:")
+			start := p.pos
+			for p.pos < len(p.src) && p.src[p.pos] >= '0' && p.src[p.pos] <= '9' {
+				p.pos++
+			}
+			off, err := strconv.Atoi(p.src[start:p.pos])
+			if err != nil {
+				return node{}, fmt.Errorf("icu: invalid offset in argument %q", name)
+			}
+			n.offset = off
+			p.skipSpace()
+		}
+		for {
+			p.skipSpace()
+			if p.peek() == '}' {
+				p.pos++
+				break
+			}
+			key := p.parseToken()
+			p.skipSpace()
+			if p.peek() != '{' {
+				return node{}, fmt.Errorf("icu: expected '{' for arm %q of argument %q", key, name)
+			}
+			p.pos++
+			body, err := p.parse(true)
+			if err != nil {
+				return node{}, err
+			}
+			n.arms[key] = body
+			p.skipSpace()
+		}
+		return n, nil
+	case "number", "date":
+		style := ""
+		if p.peek() == ',' {
+			p.pos++
+			p.skipSpace()
+			style = p.parseToken()
+			p.skipSpace()
+		}
+		if p.peek() != '}' {
+			return node{}, fmt.Errorf("icu: expected '}' closing %s argument %q", typ, name)
+		}
+		p.pos++
+		if typ == "number" {
+			return node{kind: kindNumber, arg: name, style: style}, nil
+		}
+		return node{kind: kindDate, arg: name, style: style}, nil
+	default:
+		// Unknown argument type, e.g. a real ICU arg type this engine
+		// doesn't implement yet (time, duration, spellout, ...). Treat
+		// the whole "{...}" span as opaque literal text instead of
+		// failing the parse, so a message using it still loads.
+		return node{kind: kindLiteral, lit: p.skipBalanced(start)}, nil
+	}
+}
+
+// skipBalanced returns the source text from start through the "}" that
+// closes the "{" at start, honoring nested braces and '...'-quoted
+// text, and advances p.pos past it. If the braces are never balanced,
+// it consumes through the end of the source.
+func (p *parser) skipBalanced(start int) string {
+	depth := 0
+	i := start
+	for i < len(p.src) {
+		switch p.src[i] {
+		case '\'':
+			i++
+			if i < len(p.src) && p.src[i] == '\'' {
+				i++
+				continue
+			}
+			for i < len(p.src) && p.src[i] != '\'' {
+				i++
+			}
+			if i < len(p.src) {
+				i++
+			}
+			continue
+		case '{':
+			depth++
+			i++
+		case '}':
+			depth--
+			i++
+			if depth == 0 {
+				p.pos = i
+				return p.src[start:i]
+			}
+		default:
+			i++
+		}
+	}
+	p.pos = len(p.src)
+	return p.src[start:]
+}
+
+// parseToken reads a bare identifier: a run of characters up to the next
+// space, comma, colon, or brace.
+func (p *parser) parseToken() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case ' ', '\t', '\n', ',', '{', '}', ':':
+			return p.src[start:p.pos]
+		}
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case ' ', '\t', '\n':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (p *parser) peek() byte {
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
@@ -0,0 +1,97 @@
+package icu
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func execute(t *testing.T, src string, tag language.Tag, data map[string]interface{}) string {
+	t.Helper()
+	tmpl, err := Parse(src, tag)
+	if err != nil {
+		t.Fatalf("Parse(%q) error: %v", src, err)
+	}
+	out, err := tmpl.Execute(data, "")
+	if err != nil {
+		t.Fatalf("Execute(%q) error: %v", src, err)
+	}
+	return out
+}
+
+func TestParseLiteralAndArgument(t *testing.T) {
+	got := execute(t, "Hello {name}!", language.English, map[string]interface{}{"name": "Bob"})
+	if want := "Hello Bob!"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestParsePlural(t *testing.T) {
+	tests := []struct {
+		count float64
+		want  string
+	}{
+		{0, "0 items"},
+		{1, "1 item"},
+		{2, "2 items"},
+	}
+	const src = "{count, plural, one {# item} other {# items}}"
+	for _, tt := range tests {
+		got := execute(t, src, language.English, map[string]interface{}{"count": tt.count})
+		if got != tt.want {
+			t.Errorf("count=%v: got %q, want %q", tt.count, got, tt.want)
+		}
+	}
+}
+
+func TestParsePluralOffset(t *testing.T) {
+	// offset:1 should shift both the # substitution and the category
+	// selection, so guests=2 (1 after the offset) selects "one".
+	const src = "{guests, plural, offset:1 one {you and one other} other {you and # others}}"
+	got := execute(t, src, language.English, map[string]interface{}{"guests": 2})
+	if want := "you and one other"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestParseSelectOrdinal(t *testing.T) {
+	const src = "{place, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}"
+	tests := map[float64]string{1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 11: "11th"}
+	for n, want := range tests {
+		got := execute(t, src, language.English, map[string]interface{}{"place": n})
+		if got != want {
+			t.Errorf("place=%v: got %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestParseSelect(t *testing.T) {
+	const src = "{gender, select, male {He} female {She} other {They}}"
+	got := execute(t, src, language.English, map[string]interface{}{"gender": "female"})
+	if want := "She"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestParseExplicitArm(t *testing.T) {
+	const src = "{count, plural, =0 {no items} one {# item} other {# items}}"
+	got := execute(t, src, language.English, map[string]interface{}{"count": 0})
+	if want := "no items"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestParseUnknownArgumentTypeFallsBackToLiteral(t *testing.T) {
+	const src = "before {foo, spellout} after"
+	got := execute(t, src, language.English, nil)
+	if want := "before {foo, spellout} after"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestParseQuotedLiteral(t *testing.T) {
+	got := execute(t, "it''s {n, number}", language.English, map[string]interface{}{"n": 3})
+	if want := "it's 3"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
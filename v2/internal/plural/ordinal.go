@@ -0,0 +1,72 @@
+// Hand-maintained CLDR ordinal plural rules for a representative set of
+// languages.
+//
+// See plural.go for the cardinal rules and the shared Operands/Form/Rule
+// types this table builds on.
+package plural
+
+import "golang.org/x/text/language"
+
+// OrdinalRules maps a language tag to the ordinal plural Rule that
+// applies to it. CLDR calls these rules "ordinal" because they select
+// "1st"/"2nd"/"3rd"-style forms, as opposed to the cardinal rules in
+// Rules, which select "1 item"/"2 items"-style forms.
+type OrdinalRules map[language.Tag]*Rule
+
+// OrdinalRule returns the Rule registered for tag, falling back to tag's
+// base language. It returns nil if no rule is registered for either.
+func (r OrdinalRules) OrdinalRule(tag language.Tag) *Rule {
+	if rule, ok := r[tag]; ok {
+		return rule
+	}
+	base, conf := tag.Base()
+	if conf == language.No {
+		return nil
+	}
+	return r[language.Make(base.String())]
+}
+
+// OrdinalForm returns the ordinal plural category for n under tag's
+// rule, falling back to Other if no rule is registered for tag.
+func (r OrdinalRules) OrdinalForm(tag language.Tag, n float64) Form {
+	rule := r.OrdinalRule(tag)
+	if rule == nil {
+		return Other
+	}
+	return rule.PluralFormFunc(NewOperands(n))
+}
+
+// DefaultOrdinalRules returns the ordinal plural rules for the languages
+// this package ships rules for. It is not an exhaustive CLDR table;
+// register additional entries directly in the returned map for languages
+// it is missing.
+func DefaultOrdinalRules() OrdinalRules {
+	english := func(ops *Operands) Form {
+		mod10, mod100 := ops.I%10, ops.I%100
+		switch {
+		case mod10 == 1 && mod100 != 11:
+			return One
+		case mod10 == 2 && mod100 != 12:
+			return Two
+		case mod10 == 3 && mod100 != 13:
+			return Few
+		default:
+			return Other
+		}
+	}
+	alwaysOther := func(*Operands) Form {
+		return Other
+	}
+
+	return OrdinalRules{
+		language.English:  {PluralFormFunc: english},
+		language.Turkish:  {PluralFormFunc: alwaysOther},
+		language.German:   {PluralFormFunc: alwaysOther},
+		language.Dutch:    {PluralFormFunc: alwaysOther},
+		language.French:   {PluralFormFunc: alwaysOther},
+		language.Spanish:  {PluralFormFunc: alwaysOther},
+		language.Japanese: {PluralFormFunc: alwaysOther},
+		language.Korean:   {PluralFormFunc: alwaysOther},
+		language.Chinese:  {PluralFormFunc: alwaysOther},
+	}
+}
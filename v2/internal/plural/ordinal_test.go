@@ -0,0 +1,58 @@
+package plural
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestDefaultOrdinalRulesEnglish(t *testing.T) {
+	tests := []struct {
+		n    float64
+		want Form
+	}{
+		{1, One},
+		{2, Two},
+		{3, Few},
+		{4, Other},
+		{11, Other},
+		{12, Other},
+		{13, Other},
+		{21, One},
+		{22, Two},
+		{23, Few},
+		{101, One},
+	}
+	rules := DefaultOrdinalRules()
+	for _, tt := range tests {
+		if got := rules.OrdinalForm(language.English, tt.n); got != tt.want {
+			t.Errorf("OrdinalForm(en, %v) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultOrdinalRulesAlwaysOther(t *testing.T) {
+	rules := DefaultOrdinalRules()
+	for _, tag := range []language.Tag{language.Turkish, language.German, language.Japanese} {
+		for _, n := range []float64{1, 2, 3, 11} {
+			if got := rules.OrdinalForm(tag, n); got != Other {
+				t.Errorf("OrdinalForm(%v, %v) = %q, want %q", tag, n, got, Other)
+			}
+		}
+	}
+}
+
+func TestOrdinalRuleFallsBackToBaseLanguage(t *testing.T) {
+	rules := DefaultOrdinalRules()
+	regional := language.MustParse("en-US")
+	if got, want := rules.OrdinalForm(regional, 1), One; got != want {
+		t.Errorf("OrdinalForm(en-US, 1) = %q, want %q", got, want)
+	}
+}
+
+func TestOrdinalFormUnregisteredLanguageFallsBackToOther(t *testing.T) {
+	rules := DefaultOrdinalRules()
+	if got, want := rules.OrdinalForm(language.Arabic, 1), Other; got != want {
+		t.Errorf("OrdinalForm(ar, 1) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,114 @@
+// Package plural implements CLDR plural category selection
+// (http://cldr.unicode.org/index/cldr-spec/plural-rules), independent of
+// any particular message syntax.
+package plural
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Form is a CLDR plural category.
+type Form string
+
+// The CLDR plural categories. Not every language uses every category.
+const (
+	Invalid Form = ""
+	Zero    Form = "zero"
+	One     Form = "one"
+	Two     Form = "two"
+	Few     Form = "few"
+	Many    Form = "many"
+	Other   Form = "other"
+)
+
+// Operands are the CLDR plural operands derived from a number, as defined
+// by https://www.unicode.org/reports/tr35/tr35-numbers.html#Operands.
+type Operands struct {
+	N float64 // absolute value of the number
+	I int64   // integer digits of N
+	V int     // number of visible fraction digits in N, with trailing zeros
+}
+
+// NewOperands derives the CLDR operands for n. A float64 keeps no
+// trailing zeros, so V counts the fraction digits of n's shortest
+// decimal form (1.50 gives V=1).
+func NewOperands(n float64) *Operands {
+	abs := math.Abs(n)
+	ops := &Operands{N: abs, I: int64(abs)}
+	if s := strconv.FormatFloat(abs, 'f', -1, 64); strings.Contains(s, ".") {
+		ops.V = len(s) - strings.Index(s, ".") - 1
+	}
+	return ops
+}
+
+// Rule selects the plural Form that applies to a set of Operands.
+type Rule struct {
+	PluralFormFunc func(*Operands) Form
+}
+
+// Rules maps a language tag to the cardinal plural Rule that applies to
+// it.
+type Rules map[language.Tag]*Rule
+
+// Rule returns the Rule registered for tag, falling back to tag's base
+// language. It returns nil if no rule is registered for either.
+func (r Rules) Rule(tag language.Tag) *Rule {
+	if rule, ok := r[tag]; ok {
+		return rule
+	}
+	base, conf := tag.Base()
+	if conf == language.No {
+		return nil
+	}
+	return r[language.Make(base.String())]
+}
+
+// PluralForm returns the plural category for n under tag's cardinal rule,
+// falling back to Other if no rule is registered for tag.
+func (r Rules) PluralForm(tag language.Tag, n float64) Form {
+	rule := r.Rule(tag)
+	if rule == nil {
+		return Other
+	}
+	return rule.PluralFormFunc(NewOperands(n))
+}
+
+// DefaultRules returns the cardinal plural rules for the languages this
+// package ships rules for. It is not an exhaustive CLDR table; register
+// additional entries directly in the returned map for languages it is
+// missing.
+func DefaultRules() Rules {
+	oneIfSingular := func(ops *Operands) Form {
+		if ops.I == 1 && ops.V == 0 {
+			return One
+		}
+		return Other
+	}
+	oneIfZeroOrOne := func(ops *Operands) Form {
+		if ops.N == 0 || ops.N == 1 {
+			return One
+		}
+		return Other
+	}
+	alwaysOther := func(*Operands) Form {
+		return Other
+	}
+
+	return Rules{
+		language.English:    {PluralFormFunc: oneIfSingular},
+		language.German:     {PluralFormFunc: oneIfSingular},
+		language.Dutch:      {PluralFormFunc: oneIfSingular},
+		language.Italian:    {PluralFormFunc: oneIfSingular},
+		language.Spanish:    {PluralFormFunc: oneIfSingular},
+		language.French:     {PluralFormFunc: oneIfZeroOrOne},
+		language.Portuguese: {PluralFormFunc: oneIfZeroOrOne},
+		language.Turkish:    {PluralFormFunc: alwaysOther},
+		language.Japanese:   {PluralFormFunc: alwaysOther},
+		language.Korean:     {PluralFormFunc: alwaysOther},
+		language.Chinese:    {PluralFormFunc: alwaysOther},
+	}
+}